@@ -0,0 +1,96 @@
+package usecases
+
+import (
+	"github.com/PavaniTiago/beta-intelligence/internal/domain/entities"
+	"github.com/PavaniTiago/beta-intelligence/internal/domain/repositories"
+	"github.com/PavaniTiago/beta-intelligence/pkg/cursor"
+	"github.com/PavaniTiago/beta-intelligence/pkg/sort"
+)
+
+type ProfessionUseCase interface {
+	GetProfessions(page, limit int, orderBy, where string, args []interface{}) ([]entities.Profession, int64, error)
+
+	// GetProfessionsCursor fetches one page using keyset pagination. fields
+	// must include the primary-key tiebreaker as its final entry. rawCursor
+	// is the caller's `cursor` query value, or "" for the first page; before
+	// requests the page preceding rawCursor instead of the one following it,
+	// for prev_cursor navigation. It returns the next/prev page cursors,
+	// either of which is "" when there is no page in that direction.
+	GetProfessionsCursor(fields []sort.Field, rawCursor string, before bool, limit int, where string, args []interface{}) ([]entities.Profession, string, string, error)
+}
+
+type professionUseCase struct {
+	professionRepository repositories.ProfessionRepository
+}
+
+func NewProfessionUseCase(professionRepository repositories.ProfessionRepository) ProfessionUseCase {
+	return &professionUseCase{professionRepository}
+}
+
+func (u *professionUseCase) GetProfessions(page, limit int, orderBy, where string, args []interface{}) ([]entities.Profession, int64, error) {
+	offset := (page - 1) * limit
+	return u.professionRepository.FindAll(offset, limit, orderBy, where, args)
+}
+
+func (u *professionUseCase) GetProfessionsCursor(fields []sort.Field, rawCursor string, before bool, limit int, where string, args []interface{}) ([]entities.Profession, string, string, error) {
+	orderBy := sort.OrderBy(fields)
+
+	var tok *cursor.Token
+	if rawCursor != "" {
+		decoded, err := cursor.Decode(rawCursor, orderBy)
+		if err != nil {
+			return nil, "", "", err
+		}
+		tok = &decoded
+	}
+
+	rows, hasMore, err := u.professionRepository.FindAllCursor(fields, tok, limit, before, where, args)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	if len(rows) == 0 {
+		return rows, "", "", nil
+	}
+
+	// A backward page navigated from a real cursor always has a next page
+	// (the one we came from); without one (before with no tok) there's no
+	// originating page, so no next cursor is minted. A prev page exists if
+	// we're paging backward and the repository found more rows, or if we're
+	// paging forward from anywhere but the first page.
+	hasNext := hasMore
+	hasPrev := tok != nil
+	if before {
+		hasNext = tok != nil
+		hasPrev = hasMore
+	}
+
+	columns := make([]string, len(fields))
+	for i, f := range fields {
+		columns[i] = f.Name
+	}
+
+	var nextCursor, prevCursor string
+
+	if hasNext {
+		nextCursor, err = cursor.Encode(cursor.Token{
+			Values:   rows[len(rows)-1].CursorValues(columns),
+			SortHash: cursor.SortHash(orderBy),
+		})
+		if err != nil {
+			return nil, "", "", err
+		}
+	}
+
+	if hasPrev {
+		prevCursor, err = cursor.Encode(cursor.Token{
+			Values:   rows[0].CursorValues(columns),
+			SortHash: cursor.SortHash(orderBy),
+		})
+		if err != nil {
+			return nil, "", "", err
+		}
+	}
+
+	return rows, nextCursor, prevCursor, nil
+}
@@ -0,0 +1,170 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/PavaniTiago/beta-intelligence/internal/domain/entities"
+	"github.com/PavaniTiago/beta-intelligence/pkg/cursor"
+	"github.com/PavaniTiago/beta-intelligence/pkg/sort"
+)
+
+// stubProfessionRepository is a test double for repositories.ProfessionRepository
+// that returns canned FindAllCursor results without touching a database.
+type stubProfessionRepository struct {
+	rows    []entities.Profession
+	hasMore bool
+	err     error
+}
+
+func (s *stubProfessionRepository) FindAll(offset, limit int, orderBy, where string, args []interface{}) ([]entities.Profession, int64, error) {
+	panic("not used by these tests")
+}
+
+func (s *stubProfessionRepository) FindAllCursor(fields []sort.Field, tok *cursor.Token, limit int, before bool, where string, args []interface{}) ([]entities.Profession, bool, error) {
+	return s.rows, s.hasMore, s.err
+}
+
+var testCursorFields = []sort.Field{
+	{Name: "created_at", Desc: true},
+	{Name: "profession_id", Desc: true},
+}
+
+func testProfessionRows() []entities.Profession {
+	return []entities.Profession{
+		{ProfessionID: 2, CreatedAt: time.Unix(200, 0)},
+		{ProfessionID: 1, CreatedAt: time.Unix(100, 0)},
+	}
+}
+
+// validCursor mints a token that Decode accepts for testCursorFields, as a
+// real rawCursor query value would be.
+func validCursor(t *testing.T) string {
+	t.Helper()
+	orderBy := sort.OrderBy(testCursorFields)
+	encoded, err := cursor.Encode(cursor.Token{
+		Values:   []interface{}{time.Unix(50, 0), 0},
+		SortHash: cursor.SortHash(orderBy),
+	})
+	if err != nil {
+		t.Fatalf("cursor.Encode() error = %v", err)
+	}
+	return encoded
+}
+
+func TestGetProfessionsCursor_FirstPage(t *testing.T) {
+	repo := &stubProfessionRepository{rows: testProfessionRows(), hasMore: true}
+	uc := NewProfessionUseCase(repo)
+
+	rows, next, prev, err := uc.GetProfessionsCursor(testCursorFields, "", false, 2, "", nil)
+	if err != nil {
+		t.Fatalf("GetProfessionsCursor() error = %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("len(rows) = %d, want 2", len(rows))
+	}
+	if next == "" {
+		t.Error("next_cursor should be set: more rows exist forward")
+	}
+	if prev != "" {
+		t.Error("prev_cursor should be empty on the first page")
+	}
+}
+
+func TestGetProfessionsCursor_ForwardWithCursor(t *testing.T) {
+	repo := &stubProfessionRepository{rows: testProfessionRows(), hasMore: false}
+	uc := NewProfessionUseCase(repo)
+
+	_, next, prev, err := uc.GetProfessionsCursor(testCursorFields, validCursor(t), false, 2, "", nil)
+	if err != nil {
+		t.Fatalf("GetProfessionsCursor() error = %v", err)
+	}
+	if next != "" {
+		t.Error("next_cursor should be empty: repository reported no more rows")
+	}
+	if prev == "" {
+		t.Error("prev_cursor should be set: this page was reached via a cursor")
+	}
+}
+
+func TestGetProfessionsCursor_BackwardWithCursor(t *testing.T) {
+	repo := &stubProfessionRepository{rows: testProfessionRows(), hasMore: true}
+	uc := NewProfessionUseCase(repo)
+
+	_, next, prev, err := uc.GetProfessionsCursor(testCursorFields, validCursor(t), true, 2, "", nil)
+	if err != nil {
+		t.Fatalf("GetProfessionsCursor() error = %v", err)
+	}
+	if next == "" {
+		t.Error("next_cursor should be set: we navigated back from a real page")
+	}
+	if prev == "" {
+		t.Error("prev_cursor should be set: the repository reports an earlier page")
+	}
+
+	repo.hasMore = false
+	_, next, prev, err = uc.GetProfessionsCursor(testCursorFields, validCursor(t), true, 2, "", nil)
+	if err != nil {
+		t.Fatalf("GetProfessionsCursor() error = %v", err)
+	}
+	if next == "" {
+		t.Error("next_cursor should still be set: we navigated back from a real page")
+	}
+	if prev != "" {
+		t.Error("prev_cursor should be empty: no earlier page exists")
+	}
+}
+
+func TestGetProfessionsCursor_BackwardWithoutCursor(t *testing.T) {
+	// direction=prev with no cursor: there's no originating page to return
+	// to, so next_cursor must never be minted regardless of hasMore -
+	// otherwise callers get a phantom pagination link leading nowhere.
+	repo := &stubProfessionRepository{rows: testProfessionRows(), hasMore: true}
+	uc := NewProfessionUseCase(repo)
+
+	_, next, prev, err := uc.GetProfessionsCursor(testCursorFields, "", true, 2, "", nil)
+	if err != nil {
+		t.Fatalf("GetProfessionsCursor() error = %v", err)
+	}
+	if next != "" {
+		t.Error("next_cursor must be empty: before with no cursor has no originating page")
+	}
+	if prev == "" {
+		t.Error("prev_cursor should be set: the repository reports an earlier page")
+	}
+
+	repo.hasMore = false
+	_, next, prev, err = uc.GetProfessionsCursor(testCursorFields, "", true, 2, "", nil)
+	if err != nil {
+		t.Fatalf("GetProfessionsCursor() error = %v", err)
+	}
+	if next != "" {
+		t.Error("next_cursor must be empty: before with no cursor has no originating page")
+	}
+	if prev != "" {
+		t.Error("prev_cursor should be empty: no earlier page exists")
+	}
+}
+
+func TestGetProfessionsCursor_NoRows(t *testing.T) {
+	repo := &stubProfessionRepository{rows: nil, hasMore: false}
+	uc := NewProfessionUseCase(repo)
+
+	rows, next, prev, err := uc.GetProfessionsCursor(testCursorFields, "", false, 2, "", nil)
+	if err != nil {
+		t.Fatalf("GetProfessionsCursor() error = %v", err)
+	}
+	if len(rows) != 0 || next != "" || prev != "" {
+		t.Fatalf("empty result set should yield no rows or cursors, got rows=%v next=%q prev=%q", rows, next, prev)
+	}
+}
+
+func TestGetProfessionsCursor_InvalidCursor(t *testing.T) {
+	repo := &stubProfessionRepository{rows: testProfessionRows(), hasMore: false}
+	uc := NewProfessionUseCase(repo)
+
+	_, _, _, err := uc.GetProfessionsCursor(testCursorFields, "not-a-valid-cursor!!", false, 2, "", nil)
+	if err == nil {
+		t.Fatal("expected an error for a malformed cursor")
+	}
+}
@@ -0,0 +1,51 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/PavaniTiago/beta-intelligence/pkg/sort"
+)
+
+// Profession represents a row in the professions table.
+type Profession struct {
+	ProfessionID   int       `json:"profession_id" gorm:"primaryKey;column:profession_id"`
+	ProfessionName string    `json:"profession_name" gorm:"column:profession_name"`
+	MetaPixel      string    `json:"meta_pixel" gorm:"column:meta_pixel"`
+	MetaToken      string    `json:"meta_token" gorm:"column:meta_token"`
+	CreatedAt      time.Time `json:"created_at" gorm:"column:created_at"`
+}
+
+func (Profession) TableName() string {
+	return "professions"
+}
+
+// DefaultSorts is the ORDER BY applied when a caller supplies no sort,
+// newest first with the primary key as a tiebreaker so ordering stays
+// stable when many rows share the same created_at.
+func (Profession) DefaultSorts() []sort.Field {
+	return []sort.Field{
+		{Name: "created_at", Desc: true},
+		{Name: "profession_id", Desc: true},
+	}
+}
+
+// CursorValues returns this row's values for the given column names, in
+// order, for use as a keyset-pagination cursor (see pkg/cursor).
+func (p Profession) CursorValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i, col := range columns {
+		switch col {
+		case "profession_id":
+			values[i] = p.ProfessionID
+		case "profession_name":
+			values[i] = p.ProfessionName
+		case "meta_pixel":
+			values[i] = p.MetaPixel
+		case "meta_token":
+			values[i] = p.MetaToken
+		case "created_at":
+			values[i] = p.CreatedAt
+		}
+	}
+	return values
+}
@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"github.com/PavaniTiago/beta-intelligence/internal/domain/entities"
+	"github.com/PavaniTiago/beta-intelligence/pkg/cursor"
+	"github.com/PavaniTiago/beta-intelligence/pkg/sort"
+)
+
+// ProfessionRepository abstracts persistence access for professions. where
+// and args, when where is non-empty, are a parameterized WHERE fragment
+// built from pkg/query and are applied as an additional filter.
+type ProfessionRepository interface {
+	FindAll(offset, limit int, orderBy, where string, args []interface{}) ([]entities.Profession, int64, error)
+
+	// FindAllCursor fetches one page using keyset pagination. fields must
+	// include the primary-key tiebreaker as its final entry. tok is nil for
+	// the first page. before requests the page preceding tok (for
+	// prev_cursor navigation) instead of the page following it. It requests
+	// limit+1 rows so callers can detect another page in that direction
+	// without a separate count query; rows are always returned in fields
+	// order regardless of before.
+	FindAllCursor(fields []sort.Field, tok *cursor.Token, limit int, before bool, where string, args []interface{}) ([]entities.Profession, bool, error)
+}
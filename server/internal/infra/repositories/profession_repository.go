@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/PavaniTiago/beta-intelligence/internal/domain/entities"
+	"github.com/PavaniTiago/beta-intelligence/internal/domain/repositories"
+	"github.com/PavaniTiago/beta-intelligence/pkg/cursor"
+	"github.com/PavaniTiago/beta-intelligence/pkg/sort"
+)
+
+type professionRepository struct {
+	db *gorm.DB
+}
+
+func NewProfessionRepository(db *gorm.DB) repositories.ProfessionRepository {
+	return &professionRepository{db}
+}
+
+func (r *professionRepository) FindAll(offset, limit int, orderBy, where string, args []interface{}) ([]entities.Profession, int64, error) {
+	var professions []entities.Profession
+	var total int64
+
+	countQuery := r.db.Model(&entities.Profession{})
+	if where != "" {
+		countQuery = countQuery.Where(where, args...)
+	}
+	if err := countQuery.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query := r.db.Model(&entities.Profession{})
+	if where != "" {
+		query = query.Where(where, args...)
+	}
+	if orderBy != "" {
+		query = query.Order(orderBy)
+	}
+
+	if err := query.Offset(offset).Limit(limit).Find(&professions).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return professions, total, nil
+}
+
+func (r *professionRepository) FindAllCursor(fields []sort.Field, tok *cursor.Token, limit int, before bool, where string, args []interface{}) ([]entities.Profession, bool, error) {
+	if limit < 1 {
+		limit = 1
+	}
+
+	var professions []entities.Profession
+
+	queryFields := fields
+	if before {
+		queryFields = sort.Reverse(fields)
+	}
+
+	query := r.db.Model(&entities.Profession{}).Order(sort.OrderBy(queryFields))
+	if where != "" {
+		query = query.Where(where, args...)
+	}
+
+	if tok != nil {
+		cursorWhere, cursorArgs := cursor.Where(fields, tok.Values, before)
+		query = query.Where(cursorWhere, cursorArgs...)
+	}
+
+	if err := query.Limit(limit + 1).Find(&professions).Error; err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(professions) > limit
+	if hasMore {
+		professions = professions[:limit]
+	}
+
+	if before {
+		for i, j := 0, len(professions)-1; i < j; i, j = i+1, j-1 {
+			professions[i], professions[j] = professions[j], professions[i]
+		}
+	}
+
+	return professions, hasMore, nil
+}
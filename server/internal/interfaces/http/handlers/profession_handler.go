@@ -1,12 +1,22 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
 	"strconv"
 
 	"github.com/PavaniTiago/beta-intelligence/internal/application/usecases"
+	"github.com/PavaniTiago/beta-intelligence/internal/domain/entities"
+	pkgcursor "github.com/PavaniTiago/beta-intelligence/pkg/cursor"
+	pkgquery "github.com/PavaniTiago/beta-intelligence/pkg/query"
+	pkgsort "github.com/PavaniTiago/beta-intelligence/pkg/sort"
 	"github.com/gofiber/fiber/v2"
 )
 
+// professionPKField is the tiebreaker column appended to every sort spec so
+// equal sort-key rows are never skipped or duplicated across pages.
+const professionPKField = "profession_id"
+
 type ProfessionHandler struct {
 	professionUseCase usecases.ProfessionUseCase
 }
@@ -15,35 +25,56 @@ func NewProfessionHandler(professionUseCase usecases.ProfessionUseCase) *Profess
 	return &ProfessionHandler{professionUseCase}
 }
 
+// professionSortFields allowlists the query names accepted by `sort` (and the
+// legacy `sortBy`) against their underlying column names.
+var professionSortFields = map[string]string{
+	"profession_id":   "profession_id",
+	"created_at":      "created_at",
+	"profession_name": "profession_name",
+	"meta_pixel":      "meta_pixel",
+	"meta_token":      "meta_token",
+}
+
+// professionFilterFields allowlists the fields accepted by the `q` filter
+// DSL against their underlying column names.
+var professionFilterFields = map[string]string{
+	"profession_id":   "profession_id",
+	"created_at":      "created_at",
+	"profession_name": "profession_name",
+	"meta_pixel":      "meta_pixel",
+	"meta_token":      "meta_token",
+}
+
 func (h *ProfessionHandler) GetProfessions(c *fiber.Ctx) error {
 	// Get query parameters
-	page, _ := strconv.Atoi(c.Query("page", "1"))
-	limit, _ := strconv.Atoi(c.Query("limit", "10"))
-
-	// Get sort parameters
-	sortBy := c.Query("sortBy", "created_at")
-	sortDirection := c.Query("sortDirection", "desc")
+	limit, err := strconv.Atoi(c.Query("limit", "10"))
+	if err != nil || limit < 1 {
+		limit = 10
+	}
 
-	// Validate sort direction
-	if sortDirection != "asc" && sortDirection != "desc" {
-		sortDirection = "desc"
+	sortFields, err := h.parseSort(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
 	}
 
-	// Validate sortBy field and build orderBy
-	validSortFields := map[string]string{
-		"profession_id":   "profession_id",
-		"created_at":      "created_at",
-		"profession_name": "profession_name",
-		"meta_pixel":      "meta_pixel",
-		"meta_token":      "meta_token",
+	filters, err := h.parseFilters(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
 	}
+	where, args := pkgquery.Where(filters)
 
-	orderBy := "created_at desc" // default ordering
-	if field, ok := validSortFields[sortBy]; ok {
-		orderBy = field + " " + sortDirection
+	if _, cursorMode := c.Queries()["cursor"]; cursorMode {
+		return h.getProfessionsCursor(c, sortFields, filters, where, args, limit)
 	}
 
-	professions, total, err := h.professionUseCase.GetProfessions(page, limit, orderBy)
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	orderBy := pkgsort.OrderBy(sortFields)
+
+	professions, total, err := h.professionUseCase.GetProfessions(page, limit, orderBy, where, args)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": err.Error(),
@@ -57,9 +88,111 @@ func (h *ProfessionHandler) GetProfessions(c *fiber.Ctx) error {
 			"page":              page,
 			"limit":             limit,
 			"last_page":         (total + int64(limit) - 1) / int64(limit),
-			"sort_by":           sortBy,
-			"sort_direction":    sortDirection,
-			"valid_sort_fields": getKeys(validSortFields),
+			"sort":              pkgsort.Strings(sortFields),
+			"valid_sort_fields": pkgsort.ValidFields(professionSortFields),
+			"filters":           pkgquery.Strings(filters),
+			"filterable_fields": pkgquery.ValidFields(professionFilterFields),
+		},
+	})
+}
+
+// getProfessionsCursor serves the opt-in keyset-pagination mode, entered by
+// supplying a (possibly empty) `cursor` query parameter. It appends the
+// primary key as a tiebreaker to whatever sort the caller requested so the
+// cursor never skips or duplicates rows on ties. A `direction=prev` cursor
+// walks backward from the supplied cursor instead of forward, so `meta`'s
+// `prev_cursor`/`next_cursor` are real navigable tokens rather than an echo
+// of the request.
+func (h *ProfessionHandler) getProfessionsCursor(c *fiber.Ctx, sortFields []pkgsort.Field, filters []pkgquery.Filter, where string, args []interface{}, limit int) error {
+	rawCursor := c.Query("cursor")
+
+	direction := c.Query("direction", "next")
+	if direction != "next" && direction != "prev" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fmt.Sprintf("invalid direction %q, must be \"next\" or \"prev\"", direction),
+		})
+	}
+	if direction == "prev" && rawCursor == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "direction=prev requires a cursor",
+		})
+	}
+
+	professions, nextCursor, prevCursor, err := h.professionUseCase.GetProfessionsCursor(sortFields, rawCursor, direction == "prev", limit, where, args)
+	if err != nil {
+		if errors.Is(err, pkgcursor.ErrInvalidCursor) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"data": professions,
+		"meta": fiber.Map{
+			"limit":             limit,
+			"sort":              pkgsort.Strings(sortFields),
+			"valid_sort_fields": pkgsort.ValidFields(professionSortFields),
+			"filters":           pkgquery.Strings(filters),
+			"filterable_fields": pkgquery.ValidFields(professionFilterFields),
+			"prev_cursor":       prevCursor,
+			"next_cursor":       nextCursor,
 		},
 	})
 }
+
+// parseFilters resolves the `q` filter DSL query parameter, if present.
+func (h *ProfessionHandler) parseFilters(c *fiber.Ctx) ([]pkgquery.Filter, error) {
+	raw := c.Query("q")
+	if raw == "" {
+		return nil, nil
+	}
+	return pkgquery.Parse(raw, professionFilterFields)
+}
+
+// parseSort resolves the requested sort fields, preferring the unified
+// `sort` query parameter, falling back to the legacy `sortBy`/`sortDirection`
+// pair, and finally to the resource's DefaultSorts() when the caller
+// supplies no sort at all. The primary key is always appended as a
+// tiebreaker so pagination never skips or duplicates rows on ties.
+func (h *ProfessionHandler) parseSort(c *fiber.Ctx) ([]pkgsort.Field, error) {
+	var fields []pkgsort.Field
+
+	switch {
+	case c.Query("sort") != "":
+		parsed, err := pkgsort.Parse(c.Query("sort"), professionSortFields)
+		if err != nil {
+			return nil, err
+		}
+		fields = parsed
+
+	case c.Query("sortBy") != "" || c.Query("sortDirection") != "":
+		sortBy := c.Query("sortBy", "created_at")
+		sortDirection := c.Query("sortDirection", "desc")
+		if sortDirection != "asc" && sortDirection != "desc" {
+			sortDirection = "desc"
+		}
+		if _, ok := professionSortFields[sortBy]; !ok {
+			sortBy = "created_at"
+		}
+
+		raw := sortBy
+		if sortDirection == "desc" {
+			raw = "-" + sortBy
+		}
+
+		parsed, err := pkgsort.Parse(raw, professionSortFields)
+		if err != nil {
+			return nil, err
+		}
+		fields = parsed
+
+	default:
+		fields = entities.Profession{}.DefaultSorts()
+	}
+
+	return pkgsort.WithTiebreaker(fields, professionPKField), nil
+}
@@ -0,0 +1,106 @@
+// Package cursor implements keyset ("search_after") pagination tokens used
+// by list endpoints as an alternative to OFFSET pagination, which degrades
+// once page*limit grows large.
+package cursor
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/PavaniTiago/beta-intelligence/pkg/sort"
+)
+
+// ErrInvalidCursor wraps every decode failure (malformed token or a token
+// minted under a different sort spec) so handlers can tell a bad cursor
+// apart from a repository/DB error with errors.Is and respond 400 vs 500.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Token is the decoded form of a `cursor` query value: the sort-key values
+// of the row to page from (the last row of a page for next_cursor, the
+// first row for prev_cursor), in ORDER BY column order with the primary key
+// as the final tiebreaker, bound to the sort spec it was minted under via
+// SortHash.
+type Token struct {
+	Values   []interface{} `json:"values"`
+	SortHash string        `json:"sort_hash"`
+}
+
+// SortHash derives a short hash of an ORDER BY clause so a token minted
+// under one sort spec is rejected if the caller changes sort on a later
+// request with the same cursor.
+func SortHash(orderBy string) string {
+	sum := sha256.Sum256([]byte(orderBy))
+	return base64.RawURLEncoding.EncodeToString(sum[:8])
+}
+
+// Encode serializes a Token into an opaque, base64-encoded cursor string.
+func Encode(t Token) (string, error) {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// Decode parses a cursor string and verifies it matches orderBy, returning
+// an error (for the caller to surface as 400) if the token is malformed or
+// was minted under a different sort spec.
+func Decode(raw, orderBy string) (Token, error) {
+	var t Token
+
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return t, fmt.Errorf("%w: malformed encoding", ErrInvalidCursor)
+	}
+
+	if err := json.Unmarshal(data, &t); err != nil {
+		return t, fmt.Errorf("%w: malformed payload", ErrInvalidCursor)
+	}
+
+	if t.SortHash != SortHash(orderBy) {
+		return t, fmt.Errorf("%w: sort spec changed since this cursor was issued", ErrInvalidCursor)
+	}
+
+	return t, nil
+}
+
+// Where builds a keyset-pagination predicate equivalent to
+// (f1, f2, ..., fn) > (v1, v2, ..., vn), with each column compared using
+// `>` for ascending fields and `<` for descending ones (reversed when before
+// is true, to walk the page preceding the cursor instead of the one after
+// it). It is expressed as an OR-chain of equality prefixes so per-column
+// direction mixes correctly, e.g. for fields [created_at desc,
+// profession_id asc]:
+//
+//	(created_at < ?) OR (created_at = ? AND profession_id > ?)
+func Where(fields []sort.Field, values []interface{}, before bool) (string, []interface{}) {
+	var orClauses []string
+	var args []interface{}
+
+	for i, f := range fields {
+		var andParts []string
+		for j := 0; j < i; j++ {
+			andParts = append(andParts, fmt.Sprintf("%s = ?", fields[j].Name))
+			args = append(args, values[j])
+		}
+
+		greater := !f.Desc
+		if before {
+			greater = !greater
+		}
+		op := "<"
+		if greater {
+			op = ">"
+		}
+		andParts = append(andParts, fmt.Sprintf("%s %s ?", f.Name, op))
+		args = append(args, values[i])
+
+		orClauses = append(orClauses, "("+strings.Join(andParts, " AND ")+")")
+	}
+
+	return strings.Join(orClauses, " OR "), args
+}
@@ -0,0 +1,93 @@
+package cursor
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/PavaniTiago/beta-intelligence/pkg/sort"
+)
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	orderBy := "created_at desc, profession_id desc"
+	tok := Token{
+		Values:   []interface{}{"2024-01-01", float64(42)},
+		SortHash: SortHash(orderBy),
+	}
+
+	encoded, err := Encode(tok)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	decoded, err := Decode(encoded, orderBy)
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if decoded.SortHash != tok.SortHash {
+		t.Fatalf("Decode() SortHash = %q, want %q", decoded.SortHash, tok.SortHash)
+	}
+}
+
+func TestDecode_MalformedEncoding(t *testing.T) {
+	_, err := Decode("not valid base64!!", "created_at desc")
+	if !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("Decode() error = %v, want ErrInvalidCursor", err)
+	}
+}
+
+func TestDecode_MalformedPayload(t *testing.T) {
+	// Valid base64url, but not a JSON-encoded Token.
+	raw := "bm90LWpzb24" // base64url("not-json")
+	_, err := Decode(raw, "created_at desc")
+	if !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("Decode() error = %v, want ErrInvalidCursor", err)
+	}
+}
+
+func TestDecode_SortMismatch(t *testing.T) {
+	orderBy := "created_at desc"
+	tok := Token{Values: []interface{}{"2024-01-01"}, SortHash: SortHash(orderBy)}
+
+	encoded, err := Encode(tok)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	_, err = Decode(encoded, "profession_name asc")
+	if !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("Decode() error = %v, want ErrInvalidCursor", err)
+	}
+}
+
+func TestWhere(t *testing.T) {
+	fields := []sort.Field{
+		{Name: "created_at", Desc: true},
+		{Name: "profession_id", Desc: false},
+	}
+	values := []interface{}{"2024-01-01", 42}
+
+	t.Run("forward", func(t *testing.T) {
+		where, args := Where(fields, values, false)
+		want := "(created_at < ?) OR (created_at = ? AND profession_id > ?)"
+		if where != want {
+			t.Fatalf("Where() clause = %q, want %q", where, want)
+		}
+		wantArgs := []interface{}{"2024-01-01", "2024-01-01", 42}
+		if len(args) != len(wantArgs) {
+			t.Fatalf("Where() args = %#v, want %#v", args, wantArgs)
+		}
+		for i := range wantArgs {
+			if args[i] != wantArgs[i] {
+				t.Fatalf("Where() args[%d] = %v, want %v", i, args[i], wantArgs[i])
+			}
+		}
+	})
+
+	t.Run("before inverts every comparison", func(t *testing.T) {
+		where, _ := Where(fields, values, true)
+		want := "(created_at > ?) OR (created_at = ? AND profession_id < ?)"
+		if where != want {
+			t.Fatalf("Where() clause = %q, want %q", where, want)
+		}
+	})
+}
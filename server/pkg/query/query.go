@@ -0,0 +1,128 @@
+// Package query implements the Harbor-style `q` filter DSL shared across
+// list handlers: a comma-separated list of `key op value` clauses such as
+// `profession_name=~foo,meta_pixel=123,created_at=[2024-01-01~2024-12-31]`.
+package query
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Op is a filter comparison operator.
+type Op string
+
+const (
+	OpEq       Op = "="
+	OpContains Op = "=~"
+	OpNotEq    Op = "!="
+	OpLT       Op = "<"
+	OpLTE      Op = "<="
+	OpGT       Op = ">"
+	OpGTE      Op = ">="
+	OpRange    Op = "=[]"
+)
+
+// Filter is a single parsed clause. Value holds the operand for every
+// operator except OpRange, which uses Low/High instead.
+type Filter struct {
+	Field string
+	Op    Op
+	Value string
+	Low   string
+	High  string
+}
+
+var tokenPattern = regexp.MustCompile(`^([a-zA-Z0-9_]+)(=~|!=|<=|>=|=\[|<|>|=)(.*)$`)
+
+// Parse tokenizes a raw `q` value into an ordered list of Filters,
+// validating each clause's field against allowed, which maps the public
+// query name to its underlying column name. An unknown field or malformed
+// clause returns an error so callers can respond 400.
+func Parse(raw string, allowed map[string]string) ([]Filter, error) {
+	var filters []Filter
+
+	for _, token := range strings.Split(raw, ",") {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			continue
+		}
+
+		matches := tokenPattern.FindStringSubmatch(token)
+		if matches == nil {
+			return nil, fmt.Errorf("invalid filter clause %q", token)
+		}
+
+		key, op, rest := matches[1], Op(matches[2]), matches[3]
+
+		column, ok := allowed[key]
+		if !ok {
+			return nil, fmt.Errorf("invalid filter field %q", key)
+		}
+
+		if op == "=[" {
+			if !strings.HasSuffix(rest, "]") {
+				return nil, fmt.Errorf("invalid range filter %q", token)
+			}
+			bounds := strings.SplitN(strings.TrimSuffix(rest, "]"), "~", 2)
+			if len(bounds) != 2 {
+				return nil, fmt.Errorf("invalid range filter %q", token)
+			}
+			filters = append(filters, Filter{Field: column, Op: OpRange, Low: bounds[0], High: bounds[1]})
+			continue
+		}
+
+		filters = append(filters, Filter{Field: column, Op: op, Value: rest})
+	}
+
+	return filters, nil
+}
+
+// Where builds a parameterized WHERE fragment and its args from parsed
+// filters, safe for direct use with GORM's Where(clause, args...).
+func Where(filters []Filter) (string, []interface{}) {
+	var clauses []string
+	var args []interface{}
+
+	for _, f := range filters {
+		switch f.Op {
+		case OpContains:
+			clauses = append(clauses, f.Field+" ILIKE ?")
+			args = append(args, "%"+f.Value+"%")
+		case OpRange:
+			clauses = append(clauses, f.Field+" BETWEEN ? AND ?")
+			args = append(args, f.Low, f.High)
+		default:
+			clauses = append(clauses, f.Field+" "+string(f.Op)+" ?")
+			args = append(args, f.Value)
+		}
+	}
+
+	return strings.Join(clauses, " AND "), args
+}
+
+// Strings renders parsed filters back into `key op value` form for API meta
+// responses.
+func Strings(filters []Filter) []string {
+	out := make([]string, len(filters))
+	for i, f := range filters {
+		if f.Op == OpRange {
+			out[i] = fmt.Sprintf("%s=[%s~%s]", f.Field, f.Low, f.High)
+			continue
+		}
+		out[i] = fmt.Sprintf("%s%s%s", f.Field, f.Op, f.Value)
+	}
+	return out
+}
+
+// ValidFields returns the sorted allowlist keys, used to populate API meta
+// responses so clients know which fields are filterable.
+func ValidFields(allowed map[string]string) []string {
+	keys := make([]string, 0, len(allowed))
+	for k := range allowed {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
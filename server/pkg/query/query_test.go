@@ -0,0 +1,141 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+)
+
+var testAllowed = map[string]string{
+	"profession_name": "profession_name",
+	"meta_pixel":      "meta_pixel",
+	"created_at":      "created_at",
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    []Filter
+		wantErr bool
+	}{
+		{
+			name: "exact match",
+			raw:  "meta_pixel=123",
+			want: []Filter{{Field: "meta_pixel", Op: OpEq, Value: "123"}},
+		},
+		{
+			name: "ilike substring",
+			raw:  "profession_name=~foo",
+			want: []Filter{{Field: "profession_name", Op: OpContains, Value: "foo"}},
+		},
+		{
+			name: "not equal",
+			raw:  "meta_pixel!=123",
+			want: []Filter{{Field: "meta_pixel", Op: OpNotEq, Value: "123"}},
+		},
+		{
+			name: "comparison operators",
+			raw:  "meta_pixel<=5,meta_pixel>=1",
+			want: []Filter{
+				{Field: "meta_pixel", Op: OpLTE, Value: "5"},
+				{Field: "meta_pixel", Op: OpGTE, Value: "1"},
+			},
+		},
+		{
+			name: "inclusive range",
+			raw:  "created_at=[2024-01-01~2024-12-31]",
+			want: []Filter{{Field: "created_at", Op: OpRange, Low: "2024-01-01", High: "2024-12-31"}},
+		},
+		{
+			name: "multiple clauses",
+			raw:  "profession_name=~foo,meta_pixel=123",
+			want: []Filter{
+				{Field: "profession_name", Op: OpContains, Value: "foo"},
+				{Field: "meta_pixel", Op: OpEq, Value: "123"},
+			},
+		},
+		{
+			name: "blank clauses are skipped",
+			raw:  "meta_pixel=123,,",
+			want: []Filter{{Field: "meta_pixel", Op: OpEq, Value: "123"}},
+		},
+		{
+			name: "empty string yields no filters",
+			raw:  "",
+			want: nil,
+		},
+		{
+			name:    "unknown field is rejected",
+			raw:     "nonexistent_field=1",
+			wantErr: true,
+		},
+		{
+			name:    "malformed clause is rejected",
+			raw:     "not-a-clause",
+			wantErr: true,
+		},
+		{
+			name:    "unterminated range is rejected",
+			raw:     "created_at=[2024-01-01~2024-12-31",
+			wantErr: true,
+		},
+		{
+			name:    "range missing separator is rejected",
+			raw:     "created_at=[2024-01-01]",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.raw, testAllowed)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) error = %v, wantErr %v", tt.raw, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Parse(%q) = %#v, want %#v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWhere(t *testing.T) {
+	filters := []Filter{
+		{Field: "profession_name", Op: OpContains, Value: "foo"},
+		{Field: "meta_pixel", Op: OpEq, Value: "123"},
+		{Field: "created_at", Op: OpRange, Low: "2024-01-01", High: "2024-12-31"},
+	}
+
+	where, args := Where(filters)
+	want := "profession_name ILIKE ? AND meta_pixel = ? AND created_at BETWEEN ? AND ?"
+	if where != want {
+		t.Fatalf("Where() clause = %q, want %q", where, want)
+	}
+
+	wantArgs := []interface{}{"%foo%", "123", "2024-01-01", "2024-12-31"}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Fatalf("Where() args = %#v, want %#v", args, wantArgs)
+	}
+}
+
+func TestStrings(t *testing.T) {
+	filters := []Filter{
+		{Field: "meta_pixel", Op: OpEq, Value: "123"},
+		{Field: "created_at", Op: OpRange, Low: "2024-01-01", High: "2024-12-31"},
+	}
+	want := []string{"meta_pixel=123", "created_at=[2024-01-01~2024-12-31]"}
+	if got := Strings(filters); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Strings() = %#v, want %#v", got, want)
+	}
+}
+
+func TestValidFields(t *testing.T) {
+	got := ValidFields(testAllowed)
+	want := []string{"created_at", "meta_pixel", "profession_name"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ValidFields() = %#v, want %#v", got, want)
+	}
+}
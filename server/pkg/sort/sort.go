@@ -0,0 +1,118 @@
+// Package sort implements the Harbor-style unified `sort` query parameter
+// shared across list handlers: a comma-separated list of fields, each
+// optionally prefixed with `-` (descending) or `+`/nothing (ascending).
+package sort
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Field is a single parsed ORDER BY term.
+type Field struct {
+	Name string
+	Desc bool
+}
+
+// Parse tokenizes a raw `sort` value (e.g. "-created_at,profession_name")
+// into an ordered list of Fields. Each token's field name is validated
+// against allowed, which maps the public query name to its underlying
+// column name; an unknown field returns an error so callers can respond 400.
+func Parse(raw string, allowed map[string]string) ([]Field, error) {
+	var fields []Field
+
+	for _, token := range strings.Split(raw, ",") {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			continue
+		}
+
+		desc := false
+		switch token[0] {
+		case '-':
+			desc = true
+			token = token[1:]
+		case '+':
+			token = token[1:]
+		}
+
+		column, ok := allowed[token]
+		if !ok {
+			return nil, fmt.Errorf("invalid sort field %q", token)
+		}
+
+		fields = append(fields, Field{Name: column, Desc: desc})
+	}
+
+	return fields, nil
+}
+
+// OrderBy joins parsed fields into a SQL ORDER BY clause, e.g.
+// "created_at desc, profession_name asc".
+func OrderBy(fields []Field) string {
+	clauses := make([]string, len(fields))
+	for i, f := range fields {
+		dir := "asc"
+		if f.Desc {
+			dir = "desc"
+		}
+		clauses[i] = f.Name + " " + dir
+	}
+	return strings.Join(clauses, ", ")
+}
+
+// Strings renders parsed fields as "field dir" entries for API meta responses.
+func Strings(fields []Field) []string {
+	out := make([]string, len(fields))
+	for i, f := range fields {
+		dir := "asc"
+		if f.Desc {
+			dir = "desc"
+		}
+		out[i] = f.Name + " " + dir
+	}
+	return out
+}
+
+// WithTiebreaker appends pk to fields as a final tiebreaker, matching the
+// direction of the last field, unless pk is already part of the sort spec.
+// This keeps offset and cursor pagination alike from skipping or
+// duplicating rows when every other sort value ties.
+func WithTiebreaker(fields []Field, pk string) []Field {
+	for _, f := range fields {
+		if f.Name == pk {
+			return fields
+		}
+	}
+
+	desc := false
+	if len(fields) > 0 {
+		desc = fields[len(fields)-1].Desc
+	}
+
+	return append(append([]Field{}, fields...), Field{Name: pk, Desc: desc})
+}
+
+// Reverse flips the direction of every field, for querying a page "before"
+// a keyset cursor: the DB walks the index backwards under a reversed ORDER
+// BY, and the caller re-reverses the resulting rows to restore the original
+// sort order.
+func Reverse(fields []Field) []Field {
+	out := make([]Field, len(fields))
+	for i, f := range fields {
+		out[i] = Field{Name: f.Name, Desc: !f.Desc}
+	}
+	return out
+}
+
+// ValidFields returns the sorted allowlist keys, used to populate API meta
+// responses so clients can render multi-column sort UI.
+func ValidFields(allowed map[string]string) []string {
+	keys := make([]string, 0, len(allowed))
+	for k := range allowed {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,151 @@
+package sort
+
+import (
+	"reflect"
+	"testing"
+)
+
+var testAllowed = map[string]string{
+	"profession_id":   "profession_id",
+	"created_at":      "created_at",
+	"profession_name": "profession_name",
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    []Field
+		wantErr bool
+	}{
+		{
+			name: "descending prefix",
+			raw:  "-created_at",
+			want: []Field{{Name: "created_at", Desc: true}},
+		},
+		{
+			name: "explicit ascending prefix",
+			raw:  "+profession_name",
+			want: []Field{{Name: "profession_name", Desc: false}},
+		},
+		{
+			name: "default ascending",
+			raw:  "profession_name",
+			want: []Field{{Name: "profession_name", Desc: false}},
+		},
+		{
+			name: "multiple fields with mixed direction",
+			raw:  "-created_at,profession_name",
+			want: []Field{
+				{Name: "created_at", Desc: true},
+				{Name: "profession_name", Desc: false},
+			},
+		},
+		{
+			name: "blank tokens are skipped",
+			raw:  "created_at,,profession_name",
+			want: []Field{
+				{Name: "created_at", Desc: false},
+				{Name: "profession_name", Desc: false},
+			},
+		},
+		{
+			name: "empty string yields no fields",
+			raw:  "",
+			want: nil,
+		},
+		{
+			name:    "unknown field is rejected",
+			raw:     "nonexistent_field",
+			wantErr: true,
+		},
+		{
+			name:    "unknown field after a valid one is still rejected",
+			raw:     "created_at,nonexistent_field",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.raw, testAllowed)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) error = %v, wantErr %v", tt.raw, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Parse(%q) = %#v, want %#v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderBy(t *testing.T) {
+	fields := []Field{
+		{Name: "created_at", Desc: true},
+		{Name: "profession_id", Desc: false},
+	}
+	want := "created_at desc, profession_id asc"
+	if got := OrderBy(fields); got != want {
+		t.Fatalf("OrderBy() = %q, want %q", got, want)
+	}
+}
+
+func TestWithTiebreaker(t *testing.T) {
+	t.Run("appends pk matching last field's direction", func(t *testing.T) {
+		fields := []Field{{Name: "created_at", Desc: true}}
+		got := WithTiebreaker(fields, "profession_id")
+		want := []Field{
+			{Name: "created_at", Desc: true},
+			{Name: "profession_id", Desc: true},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("WithTiebreaker() = %#v, want %#v", got, want)
+		}
+	})
+
+	t.Run("no-op when pk already present", func(t *testing.T) {
+		fields := []Field{{Name: "profession_id", Desc: false}}
+		got := WithTiebreaker(fields, "profession_id")
+		if !reflect.DeepEqual(got, fields) {
+			t.Fatalf("WithTiebreaker() = %#v, want %#v", got, fields)
+		}
+	})
+
+	t.Run("empty input defaults to ascending pk", func(t *testing.T) {
+		got := WithTiebreaker(nil, "profession_id")
+		want := []Field{{Name: "profession_id", Desc: false}}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("WithTiebreaker() = %#v, want %#v", got, want)
+		}
+	})
+}
+
+func TestReverse(t *testing.T) {
+	fields := []Field{
+		{Name: "created_at", Desc: true},
+		{Name: "profession_id", Desc: false},
+	}
+	got := Reverse(fields)
+	want := []Field{
+		{Name: "created_at", Desc: false},
+		{Name: "profession_id", Desc: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Reverse() = %#v, want %#v", got, want)
+	}
+	// Original slice must be untouched.
+	if !fields[0].Desc || fields[1].Desc {
+		t.Fatalf("Reverse() mutated its input: %#v", fields)
+	}
+}
+
+func TestValidFields(t *testing.T) {
+	got := ValidFields(testAllowed)
+	want := []string{"created_at", "profession_id", "profession_name"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ValidFields() = %#v, want %#v", got, want)
+	}
+}